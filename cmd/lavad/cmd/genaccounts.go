@@ -2,9 +2,15 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -27,8 +33,31 @@ const (
 	flagPeriodicLength = "periodic-length"
 	flagPeriodicNumber = "periodic-number"
 	flagPeriodicFirst  = "periodic-first"
+	flagVestingCliff   = "vesting-cliff"
 )
 
+// buildPeriodicVestingPeriods builds the vesting periods for a periodic
+// vesting account: an optional lockup cliff (tokens fully locked for
+// vestingCliff seconds after vesting-start-time), followed by the first
+// emission, followed by periodicNumber regular emissions of periodicLength
+// seconds each. Callers must validate vestingCliff < periodicLength*periodicNumber
+// before calling.
+func buildPeriodicVestingPeriods(vestingCliff int64, periodicFirst sdk.Coins, periodicLength, periodicNumber int64, vestingAmt sdk.Coins) []authvesting.Period {
+	periods := []authvesting.Period{}
+	if vestingCliff > 0 {
+		// tokens are fully locked until vesting-start-time + cliff; the
+		// regular periodic emissions (including the first one) start
+		// counting from the end of the cliff.
+		periods = append(periods, authvesting.Period{Length: vestingCliff, Amount: sdk.Coins{}})
+	}
+	periods = append(periods, authvesting.Period{Length: 0, Amount: periodicFirst})
+	for i := int64(0); i < periodicNumber; i++ {
+		period := authvesting.Period{Length: periodicLength, Amount: vestingAmt.QuoInt(sdk.NewInt(periodicNumber))}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -126,6 +155,11 @@ lavad add-genesis-account bela 30000000ulava  --vesting-start-time 1704707673 --
 				return fmt.Errorf("failed to parse periodicFirst amount: %w", err)
 			}
 
+			vestingCliff, err := cmd.Flags().GetInt64(flagVestingCliff)
+			if err != nil {
+				return err
+			}
+
 			// create concrete account type based on input parameters
 			var genAccount authtypes.GenesisAccount
 
@@ -165,12 +199,15 @@ lavad add-genesis-account bela 30000000ulava  --vesting-start-time 1704707673 --
 						return errors.New("periodic vesting amount must be divisble by the periodicNumber")
 					}
 
-					periods := []authvesting.Period{{Length: 0, Amount: periodicFirst}}
-					for i := int64(0); i < periodicNumber; i++ {
-						period := authvesting.Period{Length: periodicLength, Amount: vestingAmt.QuoInt(sdk.NewInt(periodicNumber))}
-						periods = append(periods, period)
+					if vestingCliff < 0 {
+						return errors.New("vesting cliff cannot be negative")
+					}
+					if vestingCliff >= periodicLength*periodicNumber {
+						return errors.New("vesting cliff must be shorter than the full periodic vesting schedule")
 					}
 
+					periods := buildPeriodicVestingPeriods(vestingCliff, periodicFirst, periodicLength, periodicNumber, vestingAmt)
+
 					genAccount = authvesting.NewPeriodicVestingAccount(baseAccount, vestingAmt.Add(periodicFirst...), vestingStart, periods)
 				case vestingStart != 0 && vestingEnd != 0:
 					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
@@ -254,7 +291,511 @@ lavad add-genesis-account bela 30000000ulava  --vesting-start-time 1704707673 --
 	cmd.Flags().Int64(flagPeriodicLength, 0, "length of the each period")
 	cmd.Flags().Int64(flagPeriodicNumber, 0, "number of periods")
 	cmd.Flags().String(flagPeriodicFirst, "", "the amount to be paid in the first emission")
+	cmd.Flags().Int64(flagVestingCliff, 0, "length of the lockup cliff before periodic vesting emissions begin, relative to vesting-start-time")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// bulkGenesisAccountEntry is a single row of a bulk genesis account import
+// file, in either JSON or CSV form. Field names match the CSV header and the
+// JSON keys of AddBulkGenesisAccountCmd's input file.
+type bulkGenesisAccountEntry struct {
+	Address        string `json:"address"`
+	Coins          string `json:"coins"`
+	ModuleAccount  bool   `json:"module_account,omitempty"`
+	VestingStart   int64  `json:"vesting_start_time,omitempty"`
+	VestingEnd     int64  `json:"vesting_end_time,omitempty"`
+	VestingAmount  string `json:"vesting_amount,omitempty"`
+	PeriodicLength int64  `json:"periodic_length,omitempty"`
+	PeriodicNumber int64  `json:"periodic_number,omitempty"`
+	PeriodicFirst  string `json:"periodic_first,omitempty"`
+	VestingCliff   int64  `json:"vesting_cliff,omitempty"`
+}
+
+// bulkGenesisAccountCSVHeader is the required column order for the CSV form
+// of the bulk genesis account import file.
+var bulkGenesisAccountCSVHeader = []string{
+	"address", "coins", "module_account", "vesting_start_time", "vesting_end_time",
+	"vesting_amount", "periodic_length", "periodic_number", "periodic_first", "vesting_cliff",
+}
+
+// buildGenesisAccount turns a single bulk entry (already merged for
+// duplicate addresses) into a concrete GenesisAccount and its bank Balance,
+// following the same vesting rules as AddGenesisAccountCmd, including
+// optional vesting-cliff lockup for periodic accounts.
+func buildGenesisAccount(entry bulkGenesisAccountEntry, coins sdk.Coins) (authtypes.GenesisAccount, banktypes.Balance, error) {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil && !entry.ModuleAccount {
+		return nil, banktypes.Balance{}, fmt.Errorf("invalid address %s: %w", entry.Address, err)
+	}
+
+	balance := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	var genAccount authtypes.GenesisAccount
+
+	vestingAmt, err := sdk.ParseCoinsNormalized(entry.VestingAmount)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to parse vesting amount for %s: %w", entry.Address, err)
+	}
+
+	switch {
+	case entry.ModuleAccount:
+		moduleAddress := authtypes.NewModuleAddress(entry.Address).String()
+		baseAccount.Address = moduleAddress
+		balance.Address = moduleAddress
+		genAccount = authtypes.NewModuleAccount(baseAccount, entry.Address, authtypes.Burner, authtypes.Staking)
+
+	case !vestingAmt.IsZero():
+		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), entry.VestingEnd)
+
+		if (balance.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
+			baseVestingAccount.OriginalVesting.IsAnyGT(balance.Coins) {
+			return nil, banktypes.Balance{}, fmt.Errorf("vesting amount cannot be greater than total amount for %s", entry.Address)
+		}
+
+		periodicFirst, err := sdk.ParseCoinsNormalized(entry.PeriodicFirst)
+		if err != nil {
+			return nil, banktypes.Balance{}, fmt.Errorf("failed to parse periodicFirst amount for %s: %w", entry.Address, err)
+		}
+
+		switch {
+		case entry.PeriodicLength != 0 || entry.PeriodicNumber != 0:
+			if entry.PeriodicLength <= 0 {
+				return nil, banktypes.Balance{}, fmt.Errorf("periodic account %s must set periodic_length", entry.Address)
+			}
+			if entry.PeriodicNumber <= 0 {
+				return nil, banktypes.Balance{}, fmt.Errorf("periodic account %s must set periodic_number", entry.Address)
+			}
+			if entry.VestingStart <= 0 {
+				return nil, banktypes.Balance{}, fmt.Errorf("periodic account %s must have vesting_start_time", entry.Address)
+			}
+			if err := periodicFirst.Validate(); err != nil {
+				return nil, banktypes.Balance{}, fmt.Errorf("periodic account %s must have a non-negative first emission: %w", entry.Address, err)
+			}
+			if !vestingAmt.QuoInt(sdk.NewInt(entry.PeriodicNumber)).MulInt(sdk.NewInt(entry.PeriodicNumber)).IsEqual(vestingAmt) {
+				return nil, banktypes.Balance{}, fmt.Errorf("periodic vesting amount for %s must be divisible by periodic_number", entry.Address)
+			}
+			if entry.VestingCliff < 0 {
+				return nil, banktypes.Balance{}, fmt.Errorf("vesting_cliff for %s cannot be negative", entry.Address)
+			}
+			if entry.VestingCliff >= entry.PeriodicLength*entry.PeriodicNumber {
+				return nil, banktypes.Balance{}, fmt.Errorf("vesting_cliff for %s must be shorter than the full periodic vesting schedule", entry.Address)
+			}
+
+			periods := buildPeriodicVestingPeriods(entry.VestingCliff, periodicFirst, entry.PeriodicLength, entry.PeriodicNumber, vestingAmt)
+
+			genAccount = authvesting.NewPeriodicVestingAccount(baseAccount, vestingAmt.Add(periodicFirst...), entry.VestingStart, periods)
+
+		case entry.VestingStart != 0 && entry.VestingEnd != 0:
+			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, entry.VestingStart)
+
+		case entry.VestingEnd != 0:
+			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+		default:
+			return nil, banktypes.Balance{}, fmt.Errorf("invalid vesting parameters for %s; must supply start and end time or end time", entry.Address)
+		}
+
+	default:
+		genAccount = baseAccount
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to validate genesis account %s: %w", entry.Address, err)
+	}
+
+	return genAccount, balance, nil
+}
+
+// parseBulkGenesisAccountsFile loads a bulk genesis account import file,
+// dispatching on its extension (.json or .csv), and aggregates coins for
+// addresses that appear more than once so each address is only processed once.
+func parseBulkGenesisAccountsFile(path string) ([]bulkGenesisAccountEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bulk genesis accounts file: %w", err)
+	}
+	defer f.Close()
+
+	var entries []bulkGenesisAccountEntry
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		if err := json.NewDecoder(f).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON bulk genesis accounts file: %w", err)
+		}
+	case ".csv":
+		entries, err = parseBulkGenesisAccountsCSV(f)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bulk genesis accounts file extension %q; must be .json or .csv", ext)
+	}
+
+	return mergeBulkGenesisAccountEntries(entries)
+}
+
+func parseBulkGenesisAccountsCSV(r io.Reader) ([]bulkGenesisAccountEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(bulkGenesisAccountCSVHeader)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	for i, col := range bulkGenesisAccountCSVHeader {
+		if i >= len(header) || strings.TrimSpace(header[i]) != col {
+			return nil, fmt.Errorf("unexpected CSV header; expected columns %v", bulkGenesisAccountCSVHeader)
+		}
+	}
+
+	var entries []bulkGenesisAccountEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+
+		moduleAccount, _ := strconv.ParseBool(orDefault(record[2], "false"))
+		vestingStart, _ := strconv.ParseInt(orDefault(record[3], "0"), 10, 64)
+		vestingEnd, _ := strconv.ParseInt(orDefault(record[4], "0"), 10, 64)
+		periodicLength, _ := strconv.ParseInt(orDefault(record[6], "0"), 10, 64)
+		periodicNumber, _ := strconv.ParseInt(orDefault(record[7], "0"), 10, 64)
+		vestingCliff, _ := strconv.ParseInt(orDefault(record[9], "0"), 10, 64)
+
+		entries = append(entries, bulkGenesisAccountEntry{
+			Address:        record[0],
+			Coins:          record[1],
+			ModuleAccount:  moduleAccount,
+			VestingStart:   vestingStart,
+			VestingEnd:     vestingEnd,
+			VestingAmount:  record[5],
+			PeriodicLength: periodicLength,
+			PeriodicNumber: periodicNumber,
+			PeriodicFirst:  record[8],
+			VestingCliff:   vestingCliff,
+		})
+	}
+
+	return entries, nil
+}
+
+func orDefault(s, def string) string {
+	if strings.TrimSpace(s) == "" {
+		return def
+	}
+	return s
+}
+
+// mergeBulkGenesisAccountEntries aggregates coins for addresses that appear
+// more than once in the input file. Vesting parameters must match across
+// duplicate rows for the same address, since an account can only carry a
+// single vesting schedule.
+func mergeBulkGenesisAccountEntries(entries []bulkGenesisAccountEntry) ([]bulkGenesisAccountEntry, error) {
+	order := make([]string, 0, len(entries))
+	merged := make(map[string]bulkGenesisAccountEntry, len(entries))
+	coinSums := make(map[string]sdk.Coins, len(entries))
+
+	for _, entry := range entries {
+		coins, err := sdk.ParseCoinsNormalized(entry.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse coins for %s: %w", entry.Address, err)
+		}
+
+		if existing, ok := merged[entry.Address]; ok {
+			if existing.VestingAmount != entry.VestingAmount ||
+				existing.VestingStart != entry.VestingStart ||
+				existing.VestingEnd != entry.VestingEnd ||
+				existing.PeriodicLength != entry.PeriodicLength ||
+				existing.PeriodicNumber != entry.PeriodicNumber ||
+				existing.PeriodicFirst != entry.PeriodicFirst ||
+				existing.VestingCliff != entry.VestingCliff ||
+				existing.ModuleAccount != entry.ModuleAccount {
+				return nil, fmt.Errorf("conflicting vesting parameters for duplicate address %s", entry.Address)
+			}
+			coinSums[entry.Address] = coinSums[entry.Address].Add(coins...)
+			continue
+		}
+
+		merged[entry.Address] = entry
+		coinSums[entry.Address] = coins
+		order = append(order, entry.Address)
+	}
+
+	result := make([]bulkGenesisAccountEntry, 0, len(order))
+	for _, addr := range order {
+		entry := merged[addr]
+		entry.Coins = coinSums[addr].Sort().String()
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
+
+// AddBulkGenesisAccountCmd returns add-bulk-genesis-accounts cobra Command.
+func AddBulkGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-bulk-genesis-accounts [path-to-json-or-csv]",
+		Short: "Add many genesis accounts to genesis.json in a single pass",
+		Long: `Add many genesis accounts to genesis.json from a single JSON or CSV file,
+instead of rewriting the genesis file once per account. Each entry specifies an
+address, a list of coins, and may optionally carry vesting parameters or mark
+the entry as a module account. Coins for addresses that appear more than once
+in the file are aggregated before the accounts are written.
+
+JSON entries look like:
+  [{"address": "lava1...", "coins": "30000000ulava"}, ...]
+
+CSV files must have the header:
+  address,coins,module_account,vesting_start_time,vesting_end_time,vesting_amount,periodic_length,periodic_number,periodic_first,vesting_cliff
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			entries, err := parseBulkGenesisAccountsFile(args[0])
+			if err != nil {
+				return err
+			}
+			if len(entries) == 0 {
+				return errors.New("bulk genesis accounts file contains no entries")
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+			// Validate and build every account up front before touching the
+			// genesis file, so a bad row in a file of thousands fails fast.
+			newAccs := make([]authtypes.GenesisAccount, 0, len(entries))
+			newBalances := make([]banktypes.Balance, 0, len(entries))
+			for _, entry := range entries {
+				coins, err := sdk.ParseCoinsNormalized(entry.Coins)
+				if err != nil {
+					return fmt.Errorf("failed to parse coins for %s: %w", entry.Address, err)
+				}
+
+				genAccount, balance, err := buildGenesisAccount(entry, coins)
+				if err != nil {
+					return err
+				}
+
+				addr, err := sdk.AccAddressFromBech32(entry.Address)
+				if err == nil && accs.Contains(addr) {
+					return fmt.Errorf("cannot add account at existing address %s", entry.Address)
+				}
+
+				newAccs = append(newAccs, genAccount)
+				newBalances = append(newBalances, balance)
+			}
+
+			accs = append(accs, newAccs...)
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenState.Balances = append(bankGenState.Balances, newBalances...)
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+
+			fmt.Fprintf(cmd.OutOrStdout(), "added %d genesis accounts\n", len(newAccs))
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const flagGenesisTime = "genesis-time"
+
+// reanchorPeriodicVestingAccount drops periods that would already have fully
+// vested by newGenesisTime and shifts the account's StartTime forward so the
+// first remaining period begins exactly at newGenesisTime. Tokens that had
+// already vested under the old schedule are removed from OriginalVesting,
+// since a relaunch should not re-lock tokens that were already unlocked.
+func reanchorPeriodicVestingAccount(account *authvesting.PeriodicVestingAccount, newGenesisTime int64) (authtypes.GenesisAccount, error) {
+	elapsed := newGenesisTime - account.StartTime
+	if elapsed <= 0 {
+		// new genesis time is at or before the original start; nothing has
+		// vested yet, so the whole schedule (start and end time) moves by the
+		// same offset.
+		account.EndTime += elapsed
+		account.StartTime = newGenesisTime
+		return account, nil
+	}
+
+	dropped := sdk.Coins{}
+	remaining := make([]authvesting.Period, 0, len(account.VestingPeriods))
+	cumulative := int64(0)
+	for _, period := range account.VestingPeriods {
+		cumulative += period.Length
+		if cumulative <= elapsed {
+			dropped = dropped.Add(period.Amount...)
+			continue
+		}
+		if len(remaining) == 0 {
+			// this period straddles the new genesis time; shorten it so the
+			// remaining schedule still ends at the original vesting end time.
+			remaining = append(remaining, authvesting.Period{Length: cumulative - elapsed, Amount: period.Amount})
+		} else {
+			remaining = append(remaining, period)
+		}
+	}
+
+	if len(remaining) == 0 {
+		// the entire schedule had already vested by the new genesis time;
+		// drop the vesting wrapper rather than leave a zero-period account
+		// that fails StartTime < EndTime validation.
+		return account.BaseAccount, nil
+	}
+
+	account.VestingPeriods = remaining
+	account.StartTime = newGenesisTime
+	account.OriginalVesting = account.OriginalVesting.Sub(dropped...)
+
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ResetVestingAccountsCmd returns reset-vesting-accounts cobra Command.
+func ResetVestingAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-vesting-accounts",
+		Short: "Re-anchor periodic vesting accounts in genesis.json to a new genesis time",
+		Long: `Walk the auth genesis state, find every PeriodicVestingAccount, and
+re-anchor its StartTime to the given --genesis-time while preserving the
+amounts that had not yet vested under the old schedule. Periods that would
+already have fully vested relative to the new genesis time are dropped, and
+the remaining schedule is shifted so its first period begins at genesis.
+
+This is for relaunching a chain from an exported genesis that contains
+accounts mid-vesting, without re-locking tokens that had already unlocked.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			genesisTime, err := cmd.Flags().GetInt64(flagGenesisTime)
+			if err != nil {
+				return err
+			}
+			if genesisTime <= 0 {
+				return errors.New("genesis-time must be a positive unix timestamp")
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
 
+			reset := 0
+			for i, acc := range accs {
+				periodicAccount, ok := acc.(*authvesting.PeriodicVestingAccount)
+				if !ok {
+					continue
+				}
+
+				replacement, err := reanchorPeriodicVestingAccount(periodicAccount, genesisTime)
+				if err != nil {
+					return fmt.Errorf("failed to re-anchor vesting account %s: %w", periodicAccount.Address, err)
+				}
+				accs[i] = replacement
+				reset++
+			}
+
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+
+			fmt.Fprintf(cmd.OutOrStdout(), "re-anchored %d periodic vesting accounts to genesis time %d\n", reset, genesisTime)
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagGenesisTime, 0, "new genesis start time (unix epoch) to re-anchor vesting schedules to")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,36 @@
+package cmd
+
+import "testing"
+
+func TestMergeBulkGenesisAccountEntriesAggregatesCoins(t *testing.T) {
+	entries := []bulkGenesisAccountEntry{
+		{Address: "lava1addr", Coins: "1000ulava"},
+		{Address: "lava1addr", Coins: "500ulava"},
+		{Address: "lava1other", Coins: "2000ulava"},
+	}
+
+	merged, err := mergeBulkGenesisAccountEntries(entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 merged entries, got %d", len(merged))
+	}
+	if merged[0].Address != "lava1addr" || merged[0].Coins != "1500ulava" {
+		t.Fatalf("expected aggregated coins 1500ulava for lava1addr, got %+v", merged[0])
+	}
+	if merged[1].Address != "lava1other" || merged[1].Coins != "2000ulava" {
+		t.Fatalf("expected 2000ulava for lava1other, got %+v", merged[1])
+	}
+}
+
+func TestMergeBulkGenesisAccountEntriesRejectsConflictingVesting(t *testing.T) {
+	entries := []bulkGenesisAccountEntry{
+		{Address: "lava1addr", Coins: "1000ulava", VestingStart: 100},
+		{Address: "lava1addr", Coins: "500ulava", VestingStart: 200},
+	}
+
+	if _, err := mergeBulkGenesisAccountEntries(entries); err == nil {
+		t.Fatal("expected an error for conflicting vesting parameters on a duplicate address")
+	}
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func newTestPeriodicVestingAccount(startTime int64, periods []authvesting.Period, total sdk.Coins) *authvesting.PeriodicVestingAccount {
+	base := authtypes.NewBaseAccount(sdk.AccAddress("test_address________"), nil, 0, 0)
+	return authvesting.NewPeriodicVestingAccount(base, total, startTime, periods)
+}
+
+func TestReanchorPeriodicVestingAccountDropsElapsedPeriods(t *testing.T) {
+	total := sdk.NewCoins(sdk.NewInt64Coin("ulava", 300))
+	periods := []authvesting.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))},
+	}
+	account := newTestPeriodicVestingAccount(1000, periods, total)
+
+	// new genesis time lands 150s in: the first period (ends at 100) has
+	// fully elapsed, the second period (ends at 200) straddles it.
+	result, err := reanchorPeriodicVestingAccount(account, 1150)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reanchored, ok := result.(*authvesting.PeriodicVestingAccount)
+	if !ok {
+		t.Fatalf("expected a PeriodicVestingAccount, got %T", result)
+	}
+	if reanchored.StartTime != 1150 {
+		t.Fatalf("expected StartTime to move to 1150, got %d", reanchored.StartTime)
+	}
+	if len(reanchored.VestingPeriods) != 2 {
+		t.Fatalf("expected 2 remaining periods, got %d", len(reanchored.VestingPeriods))
+	}
+	if reanchored.VestingPeriods[0].Length != 50 {
+		t.Fatalf("expected the straddling period to shrink to 50, got %d", reanchored.VestingPeriods[0].Length)
+	}
+	expectedRemaining := sdk.NewCoins(sdk.NewInt64Coin("ulava", 200))
+	if !reanchored.OriginalVesting.IsEqual(expectedRemaining) {
+		t.Fatalf("expected OriginalVesting to drop the vested period's amount, got %s", reanchored.OriginalVesting)
+	}
+}
+
+func TestReanchorPeriodicVestingAccountFullyElapsedDropsWrapper(t *testing.T) {
+	total := sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))
+	periods := []authvesting.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))},
+	}
+	account := newTestPeriodicVestingAccount(1000, periods, total)
+
+	result, err := reanchorPeriodicVestingAccount(account, 2000)
+	if err != nil {
+		t.Fatalf("expected a fully-elapsed schedule to succeed, got error: %v", err)
+	}
+
+	if _, stillVesting := result.(*authvesting.PeriodicVestingAccount); stillVesting {
+		t.Fatal("expected the vesting wrapper to be dropped once the schedule has fully elapsed")
+	}
+	if _, ok := result.(*authtypes.BaseAccount); !ok {
+		t.Fatalf("expected a plain BaseAccount, got %T", result)
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBuildPeriodicVestingPeriodsWithoutCliff(t *testing.T) {
+	first := sdk.NewCoins(sdk.NewInt64Coin("ulava", 200))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("ulava", 1000))
+
+	periods := buildPeriodicVestingPeriods(0, first, 1, 10, amt)
+
+	if len(periods) != 11 {
+		t.Fatalf("expected 11 periods (first emission + 10 regular), got %d", len(periods))
+	}
+	if periods[0].Length != 0 || !periods[0].Amount.IsEqual(first) {
+		t.Fatalf("expected first period to be the immediate emission, got %+v", periods[0])
+	}
+	if periods[1].Length != 1 {
+		t.Fatalf("expected regular periods to use periodicLength, got %+v", periods[1])
+	}
+}
+
+func TestBuildPeriodicVestingPeriodsWithCliff(t *testing.T) {
+	first := sdk.NewCoins(sdk.NewInt64Coin("ulava", 200))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("ulava", 1000))
+
+	periods := buildPeriodicVestingPeriods(50, first, 1, 10, amt)
+
+	if len(periods) != 12 {
+		t.Fatalf("expected 12 periods (cliff + first emission + 10 regular), got %d", len(periods))
+	}
+	if periods[0].Length != 50 || !periods[0].Amount.IsZero() {
+		t.Fatalf("expected leading cliff period of length 50 with zero amount, got %+v", periods[0])
+	}
+	if periods[1].Length != 0 || !periods[1].Amount.IsEqual(first) {
+		t.Fatalf("expected first emission to immediately follow the cliff, got %+v", periods[1])
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+func TestExpandApisByNameAndCategoryUnion(t *testing.T) {
+	apis := []spectypes.Api{
+		{Name: "eth_call", Category: "read-only"},
+		{Name: "eth_sendRawTransaction", Category: "write"},
+		{Name: "debug_traceTransaction", Category: "trace"},
+	}
+
+	allowed := expandApisByNameAndCategory(apis, []string{"eth_sendRawTransaction"}, []string{"read-only"})
+
+	if len(allowed) != 2 {
+		t.Fatalf("expected 2 allowed APIs, got %d: %v", len(allowed), allowed)
+	}
+	if !allowed["eth_call"] {
+		t.Fatal("expected the read-only category to expand to eth_call")
+	}
+	if !allowed["eth_sendRawTransaction"] {
+		t.Fatal("expected the explicit API list to be preserved in the union")
+	}
+	if allowed["debug_traceTransaction"] {
+		t.Fatal("did not expect the trace category API to be allowed")
+	}
+}
+
+func TestExpandApisByNameAndCategoryNoCategories(t *testing.T) {
+	apis := []spectypes.Api{{Name: "eth_call", Category: "read-only"}}
+
+	allowed := expandApisByNameAndCategory(apis, []string{"eth_call"}, nil)
+
+	if len(allowed) != 1 || !allowed["eth_call"] {
+		t.Fatalf("expected only the explicit API to be allowed, got %v", allowed)
+	}
+}
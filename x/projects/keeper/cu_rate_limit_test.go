@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func TestEvictCuSamplesDropsStaleSamples(t *testing.T) {
+	samples := []types.CuRateLimitSample{
+		{BlockHeight: 10, Cu: 100},
+		{BlockHeight: 95, Cu: 50},
+		{BlockHeight: 100, Cu: 25},
+	}
+
+	kept := evictCuSamples(samples, 100, 10)
+
+	if len(kept) != 2 {
+		t.Fatalf("expected 2 samples within the last 10 blocks, got %d: %v", len(kept), kept)
+	}
+	for _, s := range kept {
+		if s.BlockHeight == 10 {
+			t.Fatal("expected the stale block-10 sample to be evicted")
+		}
+	}
+}
+
+func TestCheckCuRateLimitRejectsOverLimit(t *testing.T) {
+	samples := []types.CuRateLimitSample{{BlockHeight: 100, Cu: 90}}
+
+	if err := checkCuRateLimit("proj1", samples, 100, 20, 100, 10, cuRateLimitWindowBlock); err == nil {
+		t.Fatal("expected windowed sum (90+20) to exceed limit 100")
+	}
+	if err := checkCuRateLimit("proj1", samples, 100, 5, 100, 10, cuRateLimitWindowBlock); err != nil {
+		t.Fatalf("expected windowed sum (90+5) within limit 100 to pass, got %v", err)
+	}
+}
+
+func TestAddComputeUnitsToProjectEnforcesBothWindowsFromOneEviction(t *testing.T) {
+	// regression test: the per-block check (window=1) must not evict samples
+	// the per-epoch check (a wider window) still needs to sum, or the epoch
+	// limit silently stops being enforced whenever a block limit is also set.
+	// AddComputeUnitsToProject needs a keeper and an sdk.Context, so this test
+	// repeats its window selection inline and checks the eviction/check helpers.
+	project := &types.Project{
+		Index: "proj1",
+		SubscriptionPolicy: types.Policy{
+			MaxCuPerBlock:          1000,
+			MaxCuPerEpoch:          100,
+			CuRateLimitEpochBlocks: 10,
+		},
+		CuRateLimitSamples: []types.CuRateLimitSample{
+			{BlockHeight: 95, Cu: 90},
+		},
+	}
+
+	const blockWindow = 1
+	policy := project.SubscriptionPolicy
+	epochWindow := policy.GetCuRateLimitEpochBlocks()
+
+	window := uint64(0)
+	if policy.GetMaxCuPerBlock() > 0 {
+		window = blockWindow
+	}
+	if policy.GetMaxCuPerEpoch() > 0 && epochWindow > window {
+		window = epochWindow
+	}
+
+	samples := evictCuSamples(project.CuRateLimitSamples, 100, window)
+
+	if err := checkCuRateLimit(project.Index, samples, 100, 5, policy.GetMaxCuPerBlock(), blockWindow, cuRateLimitWindowBlock); err != nil {
+		t.Fatalf("did not expect the per-block check to fail: %v", err)
+	}
+	if err := checkCuRateLimit(project.Index, samples, 100, 20, policy.GetMaxCuPerEpoch(), epochWindow, cuRateLimitWindowEpoch); err == nil {
+		t.Fatal("expected the per-epoch check to still see the block-95 sample (90+20 > 100) and reject")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/projects/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 func (k Keeper) GetProjectForBlock(ctx sdk.Context, projectID string, blockHeight uint64) (types.Project, error) {
@@ -85,12 +86,118 @@ func (k Keeper) GetProjectDevelopersPolicy(ctx sdk.Context, developerKey string,
 	return project.SubscriptionPolicy, nil
 }
 
+// ComputeUnitsRateLimitError is returned when adding compute units to a
+// project would exceed one of its configured sliding-window CU rate limits.
+// It's a distinct type (rather than a plain utils.LavaError) so upstream
+// pairing/relay code can type-assert on it and surface throttling to
+// consumers instead of silently over-charging.
+type ComputeUnitsRateLimitError struct {
+	ProjectID string
+	Window    string
+	Limit     uint64
+	Used      uint64
+	Incoming  uint64
+}
+
+func (e *ComputeUnitsRateLimitError) Error() string {
+	return fmt.Sprintf("project %s exceeded its %s compute unit rate limit: %d used + %d incoming > limit %d",
+		e.ProjectID, e.Window, e.Used, e.Incoming, e.Limit)
+}
+
+const (
+	cuRateLimitWindowBlock = "block"
+	cuRateLimitWindowEpoch = "epoch"
+)
+
+// evictCuSamples drops samples older than windowBlocks relative to
+// currentBlock and returns the surviving samples. It does not mutate samples.
+func evictCuSamples(samples []types.CuRateLimitSample, currentBlock, windowBlocks uint64) []types.CuRateLimitSample {
+	kept := make([]types.CuRateLimitSample, 0, len(samples))
+	for _, sample := range samples {
+		if currentBlock-sample.BlockHeight < windowBlocks {
+			kept = append(kept, sample)
+		}
+	}
+	return kept
+}
+
+// sumCuSamplesInWindow sums the cu of samples within windowBlocks of
+// currentBlock. samples is assumed already evicted to at least that window.
+func sumCuSamplesInWindow(samples []types.CuRateLimitSample, currentBlock, windowBlocks uint64) uint64 {
+	var sum uint64
+	for _, sample := range samples {
+		if currentBlock-sample.BlockHeight < windowBlocks {
+			sum += sample.Cu
+		}
+	}
+	return sum
+}
+
+// checkCuRateLimit verifies that adding cu at blockHeight would not push the
+// windowed sum of samples over limit. windowBlocks == 0 (or limit == 0)
+// disables the check. samples is read-only here: eviction happens once in
+// AddComputeUnitsToProject against the largest configured window, so that a
+// tighter window's check (e.g. per-block) can't discard samples a looser
+// window's check (e.g. per-epoch) still needs.
+func checkCuRateLimit(projectID string, samples []types.CuRateLimitSample, blockHeight, cu, limit, windowBlocks uint64, window string) error {
+	if limit == 0 || windowBlocks == 0 {
+		return nil
+	}
+
+	used := sumCuSamplesInWindow(samples, blockHeight, windowBlocks)
+	if used+cu > limit {
+		return &ComputeUnitsRateLimitError{
+			ProjectID: projectID,
+			Window:    window,
+			Limit:     limit,
+			Used:      used,
+			Incoming:  cu,
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) AddComputeUnitsToProject(ctx sdk.Context, project *types.Project, cu uint64) (err error) {
 	if project == nil {
 		return utils.LavaError(ctx, k.Logger(ctx), "AddComputeUnitsToProject_project_nil", nil, "project is nil")
 	}
+
+	blockHeight := uint64(ctx.BlockHeight())
+	policy := project.SubscriptionPolicy
+
+	const blockWindow = 1
+	epochWindow := policy.GetCuRateLimitEpochBlocks()
+
+	// evict once, against the largest window any enabled limit cares about,
+	// so the per-block check (the tighter window) can't throw away samples
+	// the per-epoch check (the looser window) still needs to sum.
+	window := uint64(0)
+	if policy.GetMaxCuPerBlock() > 0 {
+		window = blockWindow
+	}
+	if policy.GetMaxCuPerEpoch() > 0 && epochWindow > window {
+		window = epochWindow
+	}
+
+	samples := project.CuRateLimitSamples
+	if window > 0 {
+		samples = evictCuSamples(samples, blockHeight, window)
+	}
+
+	if err := checkCuRateLimit(project.Index, samples, blockHeight, cu, policy.GetMaxCuPerBlock(), blockWindow, cuRateLimitWindowBlock); err != nil {
+		return err
+	}
+	if err := checkCuRateLimit(project.Index, samples, blockHeight, cu, policy.GetMaxCuPerEpoch(), epochWindow, cuRateLimitWindowEpoch); err != nil {
+		return err
+	}
+
+	if window > 0 {
+		samples = append(samples, types.CuRateLimitSample{BlockHeight: blockHeight, Cu: cu})
+	}
+	project.CuRateLimitSamples = samples
 	project.UsedCu += cu
-	return k.projectsFS.ModifyEntry(ctx, project.Index, uint64(ctx.BlockHeight()), project)
+	return k.projectsFS.ModifyEntry(ctx, project.Index, blockHeight, project)
 }
 
 func (k Keeper) ValidateChainPolicies(ctx sdk.Context, policy types.Policy) error {
@@ -121,7 +228,62 @@ func (k Keeper) ValidateChainPolicies(ctx sdk.Context, policy types.Policy) erro
 				return utils.LavaError(ctx, k.Logger(ctx), "validateChainPolicies_chain_policy_api_not_found", details, "policy's spec's API not found")
 			}
 		}
+
+		// go over the chain policy's API categories and make sure each one resolves
+		// to at least one API in the spec
+		for _, category := range chainPolicy.GetApiCategories() {
+			foundCategory := false
+			for _, api := range spec.GetApis() {
+				if api.GetCategory() == category {
+					foundCategory = true
+					break
+				}
+			}
+			if !foundCategory {
+				details := map[string]string{
+					"specIndex": spec.GetIndex(),
+					"category":  category,
+				}
+				return utils.LavaError(ctx, k.Logger(ctx), "validateChainPolicies_chain_policy_category_not_found", details, "policy's spec's API category not found")
+			}
+		}
 	}
 
 	return nil
 }
+
+// ExpandChainPolicyApis resolves a chain policy's explicit API list and its API
+// categories (e.g. "read-only", "archive", "trace") against the spec's APIs and
+// returns the union of concrete API names the policy allows. The explicit list
+// and the categories compose: an API need only match one of the two to be
+// included.
+func (k Keeper) ExpandChainPolicyApis(ctx sdk.Context, chainPolicy types.ChainPolicy) (map[string]bool, error) {
+	spec, found := k.specKeeper.GetSpec(ctx, chainPolicy.GetChainId())
+	if !found {
+		return nil, utils.LavaError(ctx, k.Logger(ctx), "expandChainPolicyApis_spec_not_found", map[string]string{"specIndex": chainPolicy.GetChainId()}, "policy's spec not found")
+	}
+
+	return expandApisByNameAndCategory(spec.GetApis(), chainPolicy.GetApis(), chainPolicy.GetApiCategories()), nil
+}
+
+// expandApisByNameAndCategory returns the union of explicitApis and every
+// name in apis whose category is in categories.
+func expandApisByNameAndCategory(apis []spectypes.Api, explicitApis, categories []string) map[string]bool {
+	categorySet := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		categorySet[category] = true
+	}
+
+	allowedApis := make(map[string]bool, len(explicitApis))
+	for _, policyApi := range explicitApis {
+		allowedApis[policyApi] = true
+	}
+
+	for _, api := range apis {
+		if categorySet[api.GetCategory()] {
+			allowedApis[api.GetName()] = true
+		}
+	}
+
+	return allowedApis
+}
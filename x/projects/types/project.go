@@ -0,0 +1,21 @@
+package types
+
+const (
+	ProjectKeyAdmin     uint32 = 1 << 0
+	ProjectKeyDeveloper uint32 = 1 << 1
+)
+
+// IsAdminKey reports whether key is registered as an admin key on the project.
+func (p *Project) IsAdminKey(key string) bool {
+	for _, projectKey := range p.ProjectKeys {
+		if projectKey.Key == key && projectKey.Kinds&ProjectKeyAdmin != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// AppendKey adds projectKey to the project's key set.
+func (p *Project) AppendKey(projectKey ProjectKey) {
+	p.ProjectKeys = append(p.ProjectKeys, projectKey)
+}